@@ -0,0 +1,46 @@
+package parsers
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Positions is a helper that converts a GEOPOS-style command data to a
+// []*[2]float64. The outer data is an array whose elements are either nil
+// (when a member has no known position) or a two-element array of bulk
+// strings holding the longitude and latitude. If err is not equal to nil,
+// then Positions returns nil, err.
+func Positions(data interface{}, err error) ([]*[2]float64, error) {
+	var result []*[2]float64
+	err = sliceHelper(data, err, "Positions", func(n int) { result = make([]*[2]float64, n) }, func(i int, v interface{}) error {
+		coords, ok := v.([]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected element type for Positions, got type %T", v)
+		}
+		if len(coords) != 2 {
+			return fmt.Errorf("unexpected number of coordinates for Positions, got %d", len(coords))
+		}
+		lon, err := parseCoordinate(coords[0])
+		if err != nil {
+			return err
+		}
+		lat, err := parseCoordinate(coords[1])
+		if err != nil {
+			return err
+		}
+		result[i] = &[2]float64{lon, lat}
+		return nil
+	})
+	return result, err
+}
+
+func parseCoordinate(v interface{}) (float64, error) {
+	switch v := v.(type) {
+	case []byte:
+		return strconv.ParseFloat(string(v), 64)
+	case string:
+		return strconv.ParseFloat(v, 64)
+	default:
+		return 0, fmt.Errorf("unexpected coordinate type for Positions, got type %T", v)
+	}
+}
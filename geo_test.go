@@ -0,0 +1,30 @@
+package parsers
+
+import "testing"
+
+func TestPositions(t *testing.T) {
+	data := []interface{}{
+		[]interface{}{[]byte("13.361389"), []byte("38.115556")},
+		nil,
+	}
+	got, err := Positions(data, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(got))
+	}
+	if got[0] == nil || got[0][0] != 13.361389 || got[0][1] != 38.115556 {
+		t.Fatalf("unexpected first position: %v", got[0])
+	}
+	if got[1] != nil {
+		t.Fatalf("expected nil for missing member, got %v", got[1])
+	}
+}
+
+func TestPositionsInvalidLength(t *testing.T) {
+	data := []interface{}{[]interface{}{[]byte("1.0")}}
+	if _, err := Positions(data, nil); err == nil {
+		t.Fatal("expected error for wrong coordinate count")
+	}
+}
@@ -0,0 +1,91 @@
+package parsers
+
+import "fmt"
+
+func flatMapHelper(data interface{}, err error, name string, makeMap func(n int), assign func(key string, v interface{}) error) error {
+	if err != nil {
+		return err
+	}
+	switch data := data.(type) {
+	case []interface{}:
+		if len(data)%2 != 0 {
+			return fmt.Errorf("%s expects an even number of elements, got %d", name, len(data))
+		}
+		makeMap(len(data) / 2)
+		for i := 0; i < len(data); i += 2 {
+			key, err := String(data[i], nil)
+			if err != nil {
+				return fmt.Errorf("unexpected key type for %s, got type %T", name, data[i])
+			}
+			if err := assign(key, data[i+1]); err != nil {
+				return err
+			}
+		}
+		return nil
+	case nil:
+		return ErrNil
+	case Error:
+		return data
+	}
+	return fmt.Errorf("unexpected type for %s, got type %T", name, data)
+}
+
+// StringMap is a helper that converts a flat array command data (as
+// returned by HGETALL) to a map[string]string. If err is not equal to nil,
+// then StringMap returns nil, err. StringMap returns an error if the array
+// has an odd number of elements or a key cannot be converted by String.
+func StringMap(data interface{}, err error) (map[string]string, error) {
+	var result map[string]string
+	err = flatMapHelper(data, err, "StringMap", func(n int) { result = make(map[string]string, n) }, func(key string, v interface{}) error {
+		value, err := String(v, nil)
+		if err != nil {
+			return err
+		}
+		result[key] = value
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// IntMap is a helper that converts a flat array command data (as returned by
+// HGETALL) to a map[string]int. If err is not equal to nil, then IntMap
+// returns nil, err. IntMap returns an error if the array has an odd number
+// of elements or a key cannot be converted by String.
+func IntMap(data interface{}, err error) (map[string]int, error) {
+	var result map[string]int
+	err = flatMapHelper(data, err, "IntMap", func(n int) { result = make(map[string]int, n) }, func(key string, v interface{}) error {
+		value, err := Int(v, nil)
+		if err != nil {
+			return err
+		}
+		result[key] = value
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Int64Map is a helper that converts a flat array command data (as returned
+// by HGETALL) to a map[string]int64. If err is not equal to nil, then
+// Int64Map returns nil, err. Int64Map returns an error if the array has an
+// odd number of elements or a key cannot be converted by String.
+func Int64Map(data interface{}, err error) (map[string]int64, error) {
+	var result map[string]int64
+	err = flatMapHelper(data, err, "Int64Map", func(n int) { result = make(map[string]int64, n) }, func(key string, v interface{}) error {
+		value, err := Int64(v, nil)
+		if err != nil {
+			return err
+		}
+		result[key] = value
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
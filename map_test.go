@@ -0,0 +1,59 @@
+package parsers
+
+import "testing"
+
+func TestStringMap(t *testing.T) {
+	got, err := StringMap([]interface{}{[]byte("a"), []byte("1"), "b", "2"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]string{"a": "1", "b": "2"}
+	for k, v := range want {
+		if got[k] != v {
+			t.Fatalf("got[%q] = %q, want %q", k, got[k], v)
+		}
+	}
+
+	if _, err := StringMap([]interface{}{"a"}, nil); err == nil {
+		t.Fatal("expected error for odd number of elements")
+	}
+}
+
+func TestIntMap(t *testing.T) {
+	got, err := IntMap([]interface{}{"a", []byte("1"), "b", []byte("2")}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got["a"] != 1 || got["b"] != 2 {
+		t.Fatalf("unexpected result: %v", got)
+	}
+}
+
+func TestInt64Map(t *testing.T) {
+	got, err := Int64Map([]interface{}{"a", int64(1), "b", int64(2)}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got["a"] != 1 || got["b"] != 2 {
+		t.Fatalf("unexpected result: %v", got)
+	}
+}
+
+func TestStringMapErrorPropagation(t *testing.T) {
+	got, err := StringMap(nil, nil)
+	if err != ErrNil {
+		t.Fatalf("expected ErrNil, got %v", err)
+	}
+	if got != nil {
+		t.Fatalf("expected nil map on error, got %v", got)
+	}
+
+	wantErr := Error("boom")
+	if _, err := StringMap(wantErr, nil); err != wantErr {
+		t.Fatalf("expected Error value to propagate, got %v", err)
+	}
+
+	if got, err := StringMap([]interface{}{"a"}, nil); err == nil || got != nil {
+		t.Fatalf("expected nil map and error for odd-length array, got %v, %v", got, err)
+	}
+}
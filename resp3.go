@@ -0,0 +1,105 @@
+package parsers
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// BigInt is a helper that converts a command data to a *big.Int. If err is
+// not equal to nil, then BigInt returns nil, err. Otherwise, BigInt converts
+// the data to a *big.Int as follows:
+//
+//  Reply type    Result
+//  big number    data, nil
+//  integer       big.NewInt(data), nil
+//  bulk string   parsed data, nil
+//  nil           nil, ErrNil
+//  other         nil, error
+func BigInt(data interface{}, err error) (*big.Int, error) {
+	if err != nil {
+		return nil, err
+	}
+	switch data := data.(type) {
+	case *big.Int:
+		return data, nil
+	case int64:
+		return big.NewInt(data), nil
+	case []byte:
+		return parseBigInt(string(data))
+	case string:
+		return parseBigInt(data)
+	case nil:
+		return nil, ErrNil
+	case Error:
+		return nil, data
+	}
+	return nil, fmt.Errorf("unexpected type for BigInt, got type %T", data)
+}
+
+func parseBigInt(s string) (*big.Int, error) {
+	s = strings.TrimPrefix(s, "(")
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value for BigInt: %q", s)
+	}
+	return n, nil
+}
+
+// Map is a helper that converts a RESP3 map command data to a
+// map[interface{}]interface{}. If err is not equal to nil, then Map returns
+// nil, err. For backward compatibility with RESP2 clients, Map also accepts
+// a flat []interface{} of even length, interpreting it as alternating
+// key/value pairs.
+func Map(data interface{}, err error) (map[interface{}]interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+	switch data := data.(type) {
+	case map[interface{}]interface{}:
+		return data, nil
+	case []interface{}:
+		if len(data)%2 != 0 {
+			return nil, fmt.Errorf("parsers: Map expects an even number of elements, got %d", len(data))
+		}
+		result := make(map[interface{}]interface{}, len(data)/2)
+		for i := 0; i < len(data); i += 2 {
+			key, err := String(data[i], nil)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected key type for Map, got type %T", data[i])
+			}
+			result[key] = data[i+1]
+		}
+		return result, nil
+	case nil:
+		return nil, ErrNil
+	case Error:
+		return nil, data
+	}
+	return nil, fmt.Errorf("unexpected type for Map, got type %T", data)
+}
+
+// Set is a helper that converts a RESP3 set command data to a
+// []interface{}. If err is not equal to nil, then Set returns nil, err. Set
+// accepts both a []interface{} array and a map[interface{}]struct{}, which
+// some RESP3 clients use to represent sets.
+func Set(data interface{}, err error) ([]interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+	switch data := data.(type) {
+	case []interface{}:
+		return data, nil
+	case map[interface{}]struct{}:
+		result := make([]interface{}, 0, len(data))
+		for v := range data {
+			result = append(result, v)
+		}
+		return result, nil
+	case nil:
+		return nil, ErrNil
+	case Error:
+		return nil, data
+	}
+	return nil, fmt.Errorf("unexpected type for Set, got type %T", data)
+}
@@ -0,0 +1,96 @@
+package parsers
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestBigInt(t *testing.T) {
+	got, err := BigInt("(12345678901234567890", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want, _ := new(big.Int).SetString("12345678901234567890", 10)
+	if got.Cmp(want) != 0 {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if _, err := BigInt(int64(42), nil); err != nil {
+		t.Fatalf("unexpected error for integer input: %v", err)
+	}
+}
+
+func TestMapFromRESP3Map(t *testing.T) {
+	in := map[interface{}]interface{}{"a": "1"}
+	got, err := Map(in, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, in) {
+		t.Fatalf("got %v, want %v", got, in)
+	}
+}
+
+func TestMapFromFlatRESP2Array(t *testing.T) {
+	got, err := Map([]interface{}{[]byte("a"), []byte("1"), "b", "2"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[interface{}]interface{}{"a": []byte("1"), "b": "2"}
+	if len(got) != len(want) || got["a"] == nil || got["b"] == nil {
+		t.Fatalf("got %v, want keys a,b", got)
+	}
+}
+
+func TestMapOddLengthArray(t *testing.T) {
+	if _, err := Map([]interface{}{"a"}, nil); err == nil {
+		t.Fatal("expected error for odd-length array")
+	}
+}
+
+func TestSet(t *testing.T) {
+	got, err := Set([]interface{}{"a", "b"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("unexpected result: %v", got)
+	}
+
+	got, err = Set(map[interface{}]struct{}{"a": {}}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0] != "a" {
+		t.Fatalf("unexpected result: %v", got)
+	}
+}
+
+func TestBoolRESP3(t *testing.T) {
+	got, err := Bool(true, nil)
+	if err != nil || !got {
+		t.Fatalf("got %v, %v", got, err)
+	}
+}
+
+func TestFloat64BigNumber(t *testing.T) {
+	n, _ := new(big.Int).SetString("12345678901234567890", 10)
+	got, err := Float64(n, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got <= 0 {
+		t.Fatalf("unexpected result: %v", got)
+	}
+}
+
+func TestStringVerbatim(t *testing.T) {
+	got, err := String(VerbatimString{Format: "txt", Value: "hello"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "hello" {
+		t.Fatalf("got %q, want %q", got, "hello")
+	}
+}
@@ -0,0 +1,203 @@
+package parsers
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Scan copies successive elements from src into the destinations pointed to
+// by dest, applying the same type coercions as Int, Int64, Float64, String,
+// Bool and Uint64. Unlike those helpers, which return ErrNil for nil, a nil
+// element in src leaves the corresponding dest untouched rather than being
+// treated as an error. Scan returns the elements of src that were not
+// consumed by dest.
+//
+// dest elements must be one of: *string, *int, *int64, *uint64, *float64,
+// *bool, or *interface{}.
+func Scan(src []interface{}, dest ...interface{}) ([]interface{}, error) {
+	if len(dest) > len(src) {
+		return nil, fmt.Errorf("parsers: Scan expects at least %d elements, got %d", len(dest), len(src))
+	}
+	for i, d := range dest {
+		if err := scanValue(src[i], d); err != nil {
+			return nil, fmt.Errorf("parsers: Scan index %d: %w", i, err)
+		}
+	}
+	return src[len(dest):], nil
+}
+
+func scanValue(v interface{}, dest interface{}) error {
+	if v == nil {
+		return nil
+	}
+	switch d := dest.(type) {
+	case *string:
+		value, err := String(v, nil)
+		if err != nil {
+			return err
+		}
+		*d = value
+	case *int:
+		value, err := Int(v, nil)
+		if err != nil {
+			return err
+		}
+		*d = value
+	case *int64:
+		value, err := Int64(v, nil)
+		if err != nil {
+			return err
+		}
+		*d = value
+	case *uint64:
+		value, err := Uint64(v, nil)
+		if err != nil {
+			return err
+		}
+		*d = value
+	case *float64:
+		value, err := Float64(v, nil)
+		if err != nil {
+			return err
+		}
+		*d = value
+	case *bool:
+		value, err := Bool(v, nil)
+		if err != nil {
+			return err
+		}
+		*d = value
+	case *interface{}:
+		*d = v
+	default:
+		return fmt.Errorf("unsupported destination type %T", dest)
+	}
+	return nil
+}
+
+// structField describes a struct field that a Redis-style key can be
+// assigned into.
+type structField struct {
+	index int
+}
+
+var structFieldCache sync.Map // map[reflect.Type]map[string]structField
+
+func fieldsForType(t reflect.Type) map[string]structField {
+	if cached, ok := structFieldCache.Load(t); ok {
+		return cached.(map[string]structField)
+	}
+
+	fields := make(map[string]structField, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		name := f.Tag.Get("redis")
+		if name == "" {
+			name = f.Name
+		} else if name == "-" {
+			continue
+		}
+		fields[name] = structField{index: i}
+	}
+
+	structFieldCache.Store(t, fields)
+	return fields
+}
+
+// ScanStruct reads a flat key/value array (as produced by HGETALL) and
+// assigns the values into the fields of the struct pointed to by dest.
+// Fields are matched by their `redis` struct tag, falling back to the field
+// name when the tag is absent.
+func ScanStruct(src []interface{}, dest interface{}) error {
+	if len(src)%2 != 0 {
+		return fmt.Errorf("parsers: ScanStruct expects an even number of elements, got %d", len(src))
+	}
+
+	v := reflect.ValueOf(dest)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("parsers: ScanStruct expects a pointer to a struct, got %T", dest)
+	}
+	v = v.Elem()
+	fields := fieldsForType(v.Type())
+
+	for i := 0; i < len(src); i += 2 {
+		key, err := String(src[i], nil)
+		if err != nil {
+			return fmt.Errorf("parsers: ScanStruct key %d: %w", i, err)
+		}
+		field, ok := fields[key]
+		if !ok {
+			continue
+		}
+		fv := v.Field(field.index)
+		if err := scanValue(src[i+1], fv.Addr().Interface()); err != nil {
+			return fmt.Errorf("parsers: ScanStruct field %q: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// ScanSlice decodes an array of homogeneous records into the slice pointed
+// to by dest. When dest points to a slice of structs, each record must be
+// itself an array; fieldNames assigns record elements to struct fields in
+// order (falling back to the struct's field order when omitted). When dest
+// points to a slice of primitives, each element is converted as Scan
+// converts it (with String, Int, Int64, Uint64, Float64 or Bool), and nil
+// elements are left as the zero value.
+func ScanSlice(src []interface{}, dest interface{}, fieldNames ...string) error {
+	v := reflect.ValueOf(dest)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("parsers: ScanSlice expects a pointer to a slice, got %T", dest)
+	}
+	slice := v.Elem()
+	elemType := slice.Type().Elem()
+
+	out := reflect.MakeSlice(slice.Type(), len(src), len(src))
+
+	if elemType.Kind() == reflect.Struct {
+		names := fieldNames
+		if len(names) == 0 {
+			for i := 0; i < elemType.NumField(); i++ {
+				if elemType.Field(i).PkgPath != "" {
+					continue
+				}
+				names = append(names, elemType.Field(i).Name)
+			}
+		}
+		for i, rec := range src {
+			fields, ok := rec.([]interface{})
+			if !ok {
+				return fmt.Errorf("parsers: ScanSlice record %d: unexpected type %T", i, rec)
+			}
+			if len(fields) != len(names) {
+				return fmt.Errorf("parsers: ScanSlice record %d: expected %d fields, got %d", i, len(names), len(fields))
+			}
+			elem := out.Index(i)
+			for j, name := range names {
+				fv := elem.FieldByName(name)
+				if !fv.IsValid() || !fv.CanAddr() || !fv.CanSet() {
+					return fmt.Errorf("parsers: ScanSlice record %d: no exported field %q", i, name)
+				}
+				if err := scanValue(fields[j], fv.Addr().Interface()); err != nil {
+					return fmt.Errorf("parsers: ScanSlice record %d field %q: %w", i, name, err)
+				}
+			}
+		}
+		slice.Set(out)
+		return nil
+	}
+
+	for i, v := range src {
+		ptr := reflect.New(elemType)
+		if err := scanValue(v, ptr.Interface()); err != nil {
+			return fmt.Errorf("parsers: ScanSlice index %d: %w", i, err)
+		}
+		out.Index(i).Set(ptr.Elem())
+	}
+	slice.Set(out)
+	return nil
+}
@@ -0,0 +1,97 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScan(t *testing.T) {
+	var name string
+	var age int
+	rest, err := Scan([]interface{}{"alice", int64(30), "extra"}, &name, &age)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if name != "alice" || age != 30 {
+		t.Fatalf("got name=%q age=%d", name, age)
+	}
+	if !reflect.DeepEqual(rest, []interface{}{"extra"}) {
+		t.Fatalf("unexpected remainder: %v", rest)
+	}
+}
+
+func TestScanNilElementLeavesDestUntouched(t *testing.T) {
+	name := "preset"
+	if _, err := Scan([]interface{}{nil}, &name); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if name != "preset" {
+		t.Fatalf("expected nil element to leave dest untouched, got %q", name)
+	}
+}
+
+type scanStructFixture struct {
+	Name string `redis:"name"`
+	Age  int    `redis:"age"`
+}
+
+func TestScanStruct(t *testing.T) {
+	var dest scanStructFixture
+	err := ScanStruct([]interface{}{"name", "alice", "age", int64(30)}, &dest)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dest.Name != "alice" || dest.Age != 30 {
+		t.Fatalf("unexpected result: %+v", dest)
+	}
+}
+
+func TestScanStructNilValue(t *testing.T) {
+	dest := scanStructFixture{Name: "preset"}
+	if err := ScanStruct([]interface{}{"name", nil}, &dest); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dest.Name != "preset" {
+		t.Fatalf("expected nil value to leave field untouched, got %q", dest.Name)
+	}
+}
+
+type scanSliceFixture struct {
+	Name string
+	Age  int
+
+	unexported string
+}
+
+func TestScanSliceStructs(t *testing.T) {
+	var dest []scanSliceFixture
+	err := ScanSlice([]interface{}{
+		[]interface{}{"alice", int64(30)},
+		[]interface{}{"bob", int64(40)},
+	}, &dest)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(dest) != 2 || dest[0].Name != "alice" || dest[1].Age != 40 {
+		t.Fatalf("unexpected result: %+v", dest)
+	}
+}
+
+func TestScanSliceIgnoresUnexportedFields(t *testing.T) {
+	var out []scanSliceFixture
+	err := ScanSlice([]interface{}{[]interface{}{"alice", int64(30)}}, &out)
+	if err != nil {
+		t.Fatalf("unexpected error scanning struct with unexported field: %v", err)
+	}
+}
+
+func TestScanSlicePrimitives(t *testing.T) {
+	var out []int
+	err := ScanSlice([]interface{}{int64(1), int64(2), int64(3)}, &out)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(out, []int{1, 2, 3}) {
+		t.Fatalf("unexpected result: %v", out)
+	}
+}
@@ -0,0 +1,134 @@
+package parsers
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ByteSlices is a helper that converts an array command data to a [][]byte.
+// If err is not equal to nil, then ByteSlices returns nil, err. Nil array
+// items are converted to nil in the output slice. ByteSlices returns an
+// error if an array item is not a bulk string, simple string or nil.
+func ByteSlices(data interface{}, err error) ([][]byte, error) {
+	var result [][]byte
+	err = sliceHelper(data, err, "ByteSlices", func(n int) { result = make([][]byte, n) }, func(i int, v interface{}) error {
+		switch v := v.(type) {
+		case []byte:
+			result[i] = v
+			return nil
+		case string:
+			result[i] = []byte(v)
+			return nil
+		default:
+			return fmt.Errorf("unexpected element type for ByteSlices, got type %T", v)
+		}
+	})
+	return result, err
+}
+
+// Ints is a helper that converts an array command data to a []int. If err is
+// not equal to nil, then Ints returns nil, err. Nil array items are
+// converted to 0 in the output slice. Ints returns an error if an array item
+// is not an integer or bulk string.
+func Ints(data interface{}, err error) ([]int, error) {
+	var result []int
+	err = sliceHelper(data, err, "Ints", func(n int) { result = make([]int, n) }, func(i int, v interface{}) error {
+		switch v := v.(type) {
+		case int64:
+			n := int(v)
+			if int64(n) != v {
+				return strconv.ErrRange
+			}
+			result[i] = n
+			return nil
+		case []byte:
+			n, err := strconv.ParseInt(string(v), 10, 0)
+			if err != nil {
+				return err
+			}
+			result[i] = int(n)
+			return nil
+		default:
+			return fmt.Errorf("unexpected element type for Ints, got type %T", v)
+		}
+	})
+	return result, err
+}
+
+// Int64s is a helper that converts an array command data to a []int64. If
+// err is not equal to nil, then Int64s returns nil, err. Nil array items are
+// converted to 0 in the output slice. Int64s returns an error if an array
+// item is not an integer or bulk string.
+func Int64s(data interface{}, err error) ([]int64, error) {
+	var result []int64
+	err = sliceHelper(data, err, "Int64s", func(n int) { result = make([]int64, n) }, func(i int, v interface{}) error {
+		switch v := v.(type) {
+		case int64:
+			result[i] = v
+			return nil
+		case []byte:
+			n, err := strconv.ParseInt(string(v), 10, 64)
+			if err != nil {
+				return err
+			}
+			result[i] = n
+			return nil
+		default:
+			return fmt.Errorf("unexpected element type for Int64s, got type %T", v)
+		}
+	})
+	return result, err
+}
+
+// Uint64s is a helper that converts an array command data to a []uint64. If
+// err is not equal to nil, then Uint64s returns nil, err. Nil array items
+// are converted to 0 in the output slice. Uint64s returns an error if an
+// array item is not an integer or bulk string.
+func Uint64s(data interface{}, err error) ([]uint64, error) {
+	var result []uint64
+	err = sliceHelper(data, err, "Uint64s", func(n int) { result = make([]uint64, n) }, func(i int, v interface{}) error {
+		switch v := v.(type) {
+		case int64:
+			if v < 0 {
+				return errNegativeInt(v)
+			}
+			result[i] = uint64(v)
+			return nil
+		case []byte:
+			n, err := strconv.ParseUint(string(v), 10, 64)
+			if err != nil {
+				return err
+			}
+			result[i] = n
+			return nil
+		default:
+			return fmt.Errorf("unexpected element type for Uint64s, got type %T", v)
+		}
+	})
+	return result, err
+}
+
+// Float64s is a helper that converts an array command data to a []float64.
+// If err is not equal to nil, then Float64s returns nil, err. Nil array
+// items are converted to 0 in the output slice. Float64s returns an error if
+// an array item is not a float or bulk string.
+func Float64s(data interface{}, err error) ([]float64, error) {
+	var result []float64
+	err = sliceHelper(data, err, "Float64s", func(n int) { result = make([]float64, n) }, func(i int, v interface{}) error {
+		switch v := v.(type) {
+		case float64:
+			result[i] = v
+			return nil
+		case []byte:
+			n, err := strconv.ParseFloat(string(v), 64)
+			if err != nil {
+				return err
+			}
+			result[i] = n
+			return nil
+		default:
+			return fmt.Errorf("unexpected element type for Float64s, got type %T", v)
+		}
+	})
+	return result, err
+}
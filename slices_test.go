@@ -0,0 +1,84 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestByteSlices(t *testing.T) {
+	got, err := ByteSlices([]interface{}{[]byte("a"), nil, "b"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := [][]byte{[]byte("a"), nil, []byte("b")}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if _, err := ByteSlices([]interface{}{42}, nil); err == nil {
+		t.Fatal("expected error for unexpected element type")
+	}
+}
+
+func TestInts(t *testing.T) {
+	got, err := Ints([]interface{}{int64(1), nil, []byte("3")}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []int{1, 0, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestInt64s(t *testing.T) {
+	got, err := Int64s([]interface{}{int64(1), nil, []byte("3")}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []int64{1, 0, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestUint64s(t *testing.T) {
+	got, err := Uint64s([]interface{}{int64(1), nil, []byte("3")}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []uint64{1, 0, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if _, err := Uint64s([]interface{}{int64(-1)}, nil); err == nil {
+		t.Fatal("expected error for negative value")
+	}
+}
+
+func TestFloat64s(t *testing.T) {
+	got, err := Float64s([]interface{}{1.5, nil, []byte("2.5")}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []float64{1.5, 0, 2.5}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestSlicesErrorPropagation(t *testing.T) {
+	if _, err := Ints(nil, nil); err != ErrNil {
+		t.Fatalf("expected ErrNil, got %v", err)
+	}
+
+	wantErr := Error("boom")
+	if _, err := Ints(nil, wantErr); err != wantErr {
+		t.Fatalf("expected passthrough error, got %v", err)
+	}
+
+	if _, err := Ints(wantErr, nil); err != wantErr {
+		t.Fatalf("expected Error value to propagate, got %v", err)
+	}
+}
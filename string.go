@@ -8,13 +8,21 @@ package parsers
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"strconv"
 )
 
+// VerbatimString represents a RESP3 verbatim string reply, which carries a
+// three-character format hint (e.g. "txt" or "mkd") alongside its value.
+type VerbatimString struct {
+	Format string
+	Value  string
+}
+
 // Error represents an error returned in a command data.
 type Error string
 
-func (err Error) Error() string { return string(err.Error()) }
+func (err Error) Error() string { return string(err) }
 
 // ErrNil indicates that a data value is nil.
 var ErrNil = errors.New("nil returned")
@@ -88,6 +96,8 @@ func Int64(data interface{}, err error) (int64, error) {
 // the data to an int as follows:
 //
 //  Reply type    Result
+//  double        data, nil
+//  big number    data, nil
 //  bulk string   parsed data, nil
 //  nil           0, ErrNil
 //  other         0, error
@@ -104,6 +114,10 @@ func Float64(data interface{}, err error) (float64, error) {
 		return float64(data), nil
 	case int64:
 		return float64(data), nil
+	case *big.Int:
+		f := new(big.Float).SetInt(data)
+		n, _ := f.Float64()
+		return n, nil
 	case []byte:
 		n, err := strconv.ParseFloat(string(data), 64)
 		return n, err
@@ -125,6 +139,7 @@ func Float64(data interface{}, err error) (float64, error) {
 //  Reply type      Result
 //  bulk string     string(data), nil
 //  simple string   data, nil
+//  verbatim string data.Value, nil
 //  nil             "",  ErrNil
 //  other           "",  error
 func String(data interface{}, err error) (string, error) {
@@ -136,6 +151,8 @@ func String(data interface{}, err error) (string, error) {
 		return string(data), nil
 	case string:
 		return data, nil
+	case VerbatimString:
+		return data.Value, nil
 	case nil:
 		return "", ErrNil
 	case Error:
@@ -149,6 +166,7 @@ func String(data interface{}, err error) (string, error) {
 // data to boolean as follows:
 //
 //  Reply type      Result
+//  boolean         data, nil
 //  integer         value != 0, nil
 //  bulk string     strconv.ParseBool(data)
 //  nil             false, ErrNil
@@ -158,6 +176,8 @@ func Bool(data interface{}, err error) (bool, error) {
 		return false, err
 	}
 	switch data := data.(type) {
+	case bool:
+		return data, nil
 	case int64:
 		return data != 0, nil
 	case []byte:
@@ -221,8 +241,13 @@ func sliceHelper(data interface{}, err error, name string, makeSlice func(int),
 //
 //  Reply type      Result
 //  array           reply, nil
+//  push            reply, nil
 //  nil             nil, ErrNil
 //  other           nil, error
+//
+// RESP3 Push replies are not given a distinct Go type anywhere in this
+// package; clients decode them as []interface{} just like arrays, so the
+// existing []interface{} case already covers them.
 func Values(reply interface{}, err error) ([]interface{}, error) {
 	if err != nil {
 		return nil, err
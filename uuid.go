@@ -5,16 +5,54 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
-
 )
 
-// UUIDFromString ...
+// ParseUUID parses a string into a uuid.UUID, returning an error when the
+// string is not a valid UUID.
+func ParseUUID(s string) (uuid.UUID, error) {
+	return uuid.Parse(s)
+}
+
+// ParseUUIDPointer parses a string into a *uuid.UUID, returning an error
+// when the string is not a valid UUID.
+func ParseUUIDPointer(s string) (*uuid.UUID, error) {
+	id, err := ParseUUID(s)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// ParseUUIDBytes parses a byte slice into a uuid.UUID, returning an error
+// when the bytes do not represent a valid UUID.
+func ParseUUIDBytes(b []byte) (uuid.UUID, error) {
+	return uuid.ParseBytes(b)
+}
+
+// ParseUUIDs parses a slice of strings into a slice of uuid.UUID, returning
+// an error on the first string that is not a valid UUID.
+func ParseUUIDs(ss []string) ([]uuid.UUID, error) {
+	result := make([]uuid.UUID, len(ss))
+	for i, s := range ss {
+		id, err := ParseUUID(s)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = id
+	}
+	return result, nil
+}
+
+// UUIDFromString is testing sugar around ParseUUID: it parses uStr and
+// fails t if uStr is not a valid UUID. When t is nil, it falls back to
+// log.Fatalf so it can still be used outside of a test.
 func UUIDFromString(t *testing.T, uStr string) uuid.UUID {
-	id, err := uuid.Parse(uStr)
+	id, err := ParseUUID(uStr)
 	if err != nil {
 		if t == nil {
 			log.Fatalf("Unable to get UUID from string: %s", err.Error())
 		} else {
+			t.Helper()
 			t.Fatalf("Unable to get UUID from string: %s", err.Error())
 		}
 	}
@@ -22,7 +60,8 @@ func UUIDFromString(t *testing.T, uStr string) uuid.UUID {
 	return id
 }
 
-// UUIDFromStringPointer ...
+// UUIDFromStringPointer is like UUIDFromString but returns a *uuid.UUID: it
+// parses uStr and fails t if uStr is not a valid UUID.
 func UUIDFromStringPointer(t *testing.T, uStr string) *uuid.UUID {
 	id := UUIDFromString(t, uStr)
 
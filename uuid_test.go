@@ -0,0 +1,64 @@
+package parsers
+
+import "testing"
+
+func TestParseUUID(t *testing.T) {
+	const valid = "123e4567-e89b-12d3-a456-426614174000"
+	id, err := ParseUUID(valid)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id.String() != valid {
+		t.Fatalf("got %q, want %q", id.String(), valid)
+	}
+
+	if _, err := ParseUUID("not-a-uuid"); err == nil {
+		t.Fatal("expected error for invalid UUID string")
+	}
+}
+
+func TestParseUUIDPointer(t *testing.T) {
+	const valid = "123e4567-e89b-12d3-a456-426614174000"
+	id, err := ParseUUIDPointer(valid)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id == nil || id.String() != valid {
+		t.Fatalf("got %v", id)
+	}
+}
+
+func TestParseUUIDs(t *testing.T) {
+	ids, err := ParseUUIDs([]string{
+		"123e4567-e89b-12d3-a456-426614174000",
+		"00000000-0000-0000-0000-000000000000",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ids) != 2 {
+		t.Fatalf("expected 2 ids, got %d", len(ids))
+	}
+
+	if _, err := ParseUUIDs([]string{"not-a-uuid"}); err == nil {
+		t.Fatal("expected error for invalid UUID string")
+	}
+}
+
+func TestUUIDFromString(t *testing.T) {
+	const valid = "123e4567-e89b-12d3-a456-426614174000"
+	id := UUIDFromString(t, valid)
+	if id.String() != valid {
+		t.Fatalf("got %q, want %q", id.String(), valid)
+	}
+}
+
+// TestErrorErrorDoesNotRecurse guards against a regression where
+// Error.Error() called itself instead of converting the underlying string,
+// which overflowed the stack.
+func TestErrorErrorDoesNotRecurse(t *testing.T) {
+	err := Error("boom")
+	if got := err.Error(); got != "boom" {
+		t.Fatalf("got %q, want %q", got, "boom")
+	}
+}